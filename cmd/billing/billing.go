@@ -74,6 +74,60 @@ var (
 	# Shortened with alias commands
 	vultr-cli billing i i 123456
 	`
+
+	invoicePDFLong    = `Render a specific invoice on your account to a PDF`
+	invoicePDFExample = `
+	# Full example
+	vultr-cli billing invoice pdf 123456 --output invoice.pdf
+
+	# Shortened with alias commands
+	vultr-cli billing i p 123456
+	`
+
+	summaryLong    = `Aggregate billing history by month, service category, and optionally region`
+	summaryExample = `
+	# Full example
+	vultr-cli billing summary --group-by month
+
+	# Shortened with alias commands
+	vultr-cli billing s --group-by quarter --group-by-region
+	`
+
+	budgetLong    = `Manage local spend budgets used by 'billing budget check'`
+	budgetExample = `
+	# Full example
+	vultr-cli billing budget
+
+	# Shortened with alias commands
+	vultr-cli billing b
+	`
+
+	budgetSetLong    = `Create or update a local spend budget`
+	budgetSetExample = `
+	# Full example
+	vultr-cli billing budget set default --monthly 100 --warn-at 80
+	`
+
+	budgetListLong    = `List all local spend budgets`
+	budgetListExample = `
+	# Full example
+	vultr-cli billing budget list
+	`
+
+	budgetDeleteLong    = `Delete a local spend budget`
+	budgetDeleteExample = `
+	# Full example
+	vultr-cli billing budget delete default
+	`
+
+	budgetCheckLong    = `Check month-to-date spend against a local budget, exiting non-zero on breach`
+	budgetCheckExample = `
+	# Full example
+	vultr-cli billing budget check
+
+	# Suitable for cron/CI
+	vultr-cli billing budget check default --format json
+	`
 )
 
 func NewCmdBilling(base *cli.Base) *cobra.Command {
@@ -111,12 +165,15 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 		Example: invoiceListExample,
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Base.Options = utils.GetPaging(cmd)
+			o.setCursorOptions(cmd)
+
 			invs, meta, err := o.listInvoices()
 			if err != nil {
 				printer.Error(fmt.Errorf("error retrieving billing invoice list : %v", err))
 				os.Exit(1)
 			}
-			data := &BillingInvoicesPrinter{Invoices: invs, Meta: meta}
+			firstID, lastID := firstLastInvoiceIDs(invs)
+			data := &BillingInvoicesPrinter{Invoices: invs, Meta: meta, Page: o.page(meta, firstID, lastID)}
 			o.Base.Printer.Display(data, err)
 		},
 	}
@@ -128,6 +185,7 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 		utils.PerPageDefault,
 		"(optional) Number of items requested per page. Default is 100 and Max is 500.",
 	)
+	addCursorFlags(invoicesList)
 
 	// Invoice Get
 	invoiceGet := &cobra.Command{
@@ -169,6 +227,8 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Base.Options = utils.GetPaging(cmd)
+			o.setCursorOptions(cmd)
+
 			id, errConv := strconv.Atoi(args[0])
 			if errConv != nil {
 				printer.Error(fmt.Errorf("error converting invoice item id : %v", errConv))
@@ -182,8 +242,13 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 				printer.Error(fmt.Errorf("error retrieving billing invoice item list : %v", err))
 				os.Exit(1)
 			}
-			data := &BillingInvoiceItemsPrinter{InvoiceItems: items, Meta: meta}
-			o.Base.Printer.Display(data, err)
+			data := &BillingInvoiceItemsPrinter{
+				InvoiceItems: items,
+				Meta:         meta,
+				Page:         o.page(meta, "", ""), // invoice items have no stable ID to track
+				NoColor:      !o.Base.Printer.ColorEnabled(),
+			}
+			o.Base.Printer.DisplayPaged(data, err)
 		},
 	}
 
@@ -194,11 +259,60 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 		utils.PerPageDefault,
 		fmt.Sprintf("(optional) Number of items requested per page. Default is %d and Max is 500.", utils.PerPageDefault),
 	)
+	addCursorFlags(invoiceItemsList)
+
+	// Invoice PDF
+	invoicePDF := &cobra.Command{
+		Use:     "pdf <INVOICE_ID>",
+		Short:   "render an invoice to PDF",
+		Aliases: []string{"p"},
+		Long:    invoicePDFLong,
+		Example: invoicePDFExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("please provide an invoice ID")
+			}
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			id, errConv := strconv.Atoi(args[0])
+			if errConv != nil {
+				printer.Error(fmt.Errorf("error converting invoice id : %v", errConv))
+				os.Exit(1)
+			}
+			o.InvoiceItemID = id
+
+			format, _ := cmd.Flags().GetString("format")
+			output, _ := cmd.Flags().GetString("output")
+			if output == "" {
+				ext := "pdf"
+				if format == "html" {
+					ext = "html"
+				}
+				output = fmt.Sprintf("invoice-%d.%s", id, ext)
+			}
+			tmplPath, _ := cmd.Flags().GetString("template")
+
+			path, size, err := o.renderInvoicePDF(output, tmplPath, format)
+			if err != nil {
+				printer.Error(fmt.Errorf("error rendering invoice pdf : %v", err))
+				os.Exit(1)
+			}
+
+			data := &BillingInvoicePDFPrinter{Path: path, Bytes: size}
+			o.Base.Printer.Display(data, nil)
+		},
+	}
+
+	invoicePDF.Flags().StringP("output", "o", "", "(optional) Path to write the rendered invoice to. Defaults to invoice-<ID>.pdf, or invoice-<ID>.html with --format html")
+	invoicePDF.Flags().String("template", "", "(optional) Path to a custom HTML template used to render the invoice, only supported with --format html")
+	invoicePDF.Flags().String("format", "pdf", "(optional) Output format for the rendered invoice: pdf or html")
 
 	invoice.AddCommand(
 		invoicesList,
 		invoiceGet,
 		invoiceItemsList,
+		invoicePDF,
 	)
 
 	// History
@@ -219,13 +333,21 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 		Example: historyListExample,
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Base.Options = utils.GetPaging(cmd)
+			o.setCursorOptions(cmd)
+
 			hs, meta, err := o.listHistory()
 			if err != nil {
 				printer.Error(fmt.Errorf("error retrieving billing history list : %v", err))
 				os.Exit(1)
 			}
-			data := &BillingHistoryPrinter{Billing: hs, Meta: meta}
-			o.Base.Printer.Display(data, err)
+			firstID, lastID := firstLastHistoryIDs(hs)
+			data := &BillingHistoryPrinter{
+				Billing: hs,
+				Meta:    meta,
+				Page:    o.page(meta, firstID, lastID),
+				NoColor: !o.Base.Printer.ColorEnabled(),
+			}
+			o.Base.Printer.DisplayPaged(data, err)
 		},
 	}
 
@@ -236,14 +358,155 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 		utils.PerPageDefault,
 		"(optional) Number of items requested per page. Default is 100 and Max is 500.",
 	)
+	addCursorFlags(historyList)
 
 	history.AddCommand(
 		historyList,
 	)
 
+	// Summary
+	summary := &cobra.Command{
+		Use:     "summary",
+		Aliases: []string{"s"},
+		Short:   "summarize billing history by month, category, and region",
+		Long:    summaryLong,
+		Example: summaryExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			so, errParse := parseSummaryFlags(cmd)
+			if errParse != nil {
+				printer.Error(fmt.Errorf("error parsing summary flags : %v", errParse))
+				os.Exit(1)
+			}
+
+			data, err := o.summary(so)
+			if err != nil {
+				printer.Error(fmt.Errorf("error summarizing billing history : %v", err))
+				os.Exit(1)
+			}
+			o.Base.Printer.Display(data, nil)
+		},
+	}
+
+	summary.Flags().String("from", "", "(optional) RFC3339 start date to filter billing history from")
+	summary.Flags().String("to", "", "(optional) RFC3339 end date to filter billing history to")
+	summary.Flags().String("group-by", "month", "(optional) Period to group by: month, quarter, or year")
+	summary.Flags().Bool("group-by-region", false, "(optional) Also group by region, parsed from the item description")
+
+	// Budget
+	budget := &cobra.Command{
+		Use:     "budget",
+		Aliases: []string{"b"},
+		Short:   "manage local spend budgets",
+		Long:    budgetLong,
+		Example: budgetExample,
+	}
+
+	budgetSet := &cobra.Command{
+		Use:     "set [NAME]",
+		Short:   "create or update a budget",
+		Long:    budgetSetLong,
+		Example: budgetSetExample,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := "default"
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			monthly, _ := cmd.Flags().GetFloat64("monthly")
+			warnAt, _ := cmd.Flags().GetFloat64("warn-at")
+
+			budgets, err := setBudget(name, monthly, warnAt)
+			if err != nil {
+				printer.Error(fmt.Errorf("error saving budget : %v", err))
+				os.Exit(1)
+			}
+			o.Base.Printer.Display(&BillingBudgetPrinter{Budgets: budgets}, nil)
+		},
+	}
+
+	budgetSet.Flags().Float64("monthly", 0, "(required) Hard monthly spend limit")
+	budgetSet.Flags().Float64("warn-at", 0, "(optional) Spend level at which to warn before the hard limit")
+
+	budgetList := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l"},
+		Short:   "list budgets",
+		Long:    budgetListLong,
+		Example: budgetListExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			budgets, err := loadBudgets()
+			if err != nil {
+				printer.Error(fmt.Errorf("error listing budgets : %v", err))
+				os.Exit(1)
+			}
+			o.Base.Printer.Display(&BillingBudgetPrinter{Budgets: budgets}, nil)
+		},
+	}
+
+	budgetDelete := &cobra.Command{
+		Use:     "delete <NAME>",
+		Short:   "delete a budget",
+		Long:    budgetDeleteLong,
+		Example: budgetDeleteExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("please provide a budget name")
+			}
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := deleteBudget(args[0]); err != nil {
+				printer.Error(fmt.Errorf("error deleting budget : %v", err))
+				os.Exit(1)
+			}
+			fmt.Printf("Budget %q has been deleted\n", args[0])
+		},
+	}
+
+	budgetCheck := &cobra.Command{
+		Use:     "check [NAME]",
+		Short:   "check month-to-date spend against a budget",
+		Long:    budgetCheckLong,
+		Example: budgetCheckExample,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := "default"
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			breach, code, err := o.checkBudget(name)
+			if err != nil {
+				printer.Error(fmt.Errorf("error checking budget : %v", err))
+				os.Exit(1)
+			}
+
+			if format, _ := cmd.Flags().GetString("format"); format != "" {
+				o.Base.Printer.Output = format
+			}
+			o.Base.Printer.Display(&BillingBudgetCheckPrinter{Breach: *breach}, nil)
+
+			if code != 0 {
+				os.Exit(code)
+			}
+		},
+	}
+
+	budgetCheck.Flags().String("format", "", "(optional) Output format for the check result: table, json, yaml, or csv. Overrides --output.")
+
+	budget.AddCommand(
+		budgetSet,
+		budgetList,
+		budgetDelete,
+		budgetCheck,
+	)
+
 	cmd.AddCommand(
 		history,
 		invoice,
+		summary,
+		budget,
 	)
 
 	return cmd
@@ -252,24 +515,96 @@ func NewCmdBilling(base *cli.Base) *cobra.Command {
 type options struct {
 	Base          *cli.Base
 	InvoiceItemID int
+	StartingAfter string
+	EndingBefore  string
+	Follow        bool
 }
 
 func (b *options) listHistory() ([]govultr.History, *govultr.Meta, error) {
+	if b.Follow {
+		return b.listHistoryAll()
+	}
 	hs, meta, _, err := b.Base.Client.Billing.ListHistory(b.Base.Context, b.Base.Options)
 	return hs, meta, err
 }
 
+func (b *options) listHistoryAll() ([]govultr.History, *govultr.Meta, error) {
+	var all []govultr.History
+	var meta *govultr.Meta
+
+	for {
+		hs, m, _, err := b.Base.Client.Billing.ListHistory(b.Base.Context, b.Base.Options)
+		if err != nil {
+			return nil, nil, err
+		}
+		all = append(all, hs...)
+		meta = m
+
+		if !advanceCursor(b.Base.Options, m) {
+			break
+		}
+	}
+
+	return all, meta, nil
+}
+
 func (b *options) get() (*govultr.Invoice, error) {
 	inv, _, err := b.Base.Client.Billing.GetInvoice(b.Base.Context, b.Base.Args[0])
 	return inv, err
 }
 
 func (b *options) listInvoices() ([]govultr.Invoice, *govultr.Meta, error) {
+	if b.Follow {
+		return b.listInvoicesAll()
+	}
 	invs, meta, _, err := b.Base.Client.Billing.ListInvoices(b.Base.Context, b.Base.Options)
 	return invs, meta, err
 }
 
+func (b *options) listInvoicesAll() ([]govultr.Invoice, *govultr.Meta, error) {
+	var all []govultr.Invoice
+	var meta *govultr.Meta
+
+	for {
+		invs, m, _, err := b.Base.Client.Billing.ListInvoices(b.Base.Context, b.Base.Options)
+		if err != nil {
+			return nil, nil, err
+		}
+		all = append(all, invs...)
+		meta = m
+
+		if !advanceCursor(b.Base.Options, m) {
+			break
+		}
+	}
+
+	return all, meta, nil
+}
+
 func (b *options) listInvoiceItems() ([]govultr.InvoiceItem, *govultr.Meta, error) {
+	if b.Follow {
+		return b.listInvoiceItemsAll()
+	}
 	items, meta, _, err := b.Base.Client.Billing.ListInvoiceItems(b.Base.Context, b.InvoiceItemID, b.Base.Options)
 	return items, meta, err
 }
+
+func (b *options) listInvoiceItemsAll() ([]govultr.InvoiceItem, *govultr.Meta, error) {
+	var all []govultr.InvoiceItem
+	var meta *govultr.Meta
+
+	for {
+		items, m, _, err := b.Base.Client.Billing.ListInvoiceItems(b.Base.Context, b.InvoiceItemID, b.Base.Options)
+		if err != nil {
+			return nil, nil, err
+		}
+		all = append(all, items...)
+		meta = m
+
+		if !advanceCursor(b.Base.Options, m) {
+			break
+		}
+	}
+
+	return all, meta, nil
+}
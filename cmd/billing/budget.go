@@ -0,0 +1,148 @@
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const budgetConfigKey = "budgets"
+
+// Budget is a user-defined month-to-date spend threshold, persisted in the
+// vultr-cli config file alongside the API key.
+type Budget struct {
+	Name    string  `mapstructure:"name" json:"name"`
+	Monthly float64 `mapstructure:"monthly" json:"monthly"`
+	WarnAt  float64 `mapstructure:"warn_at" json:"warn_at" yaml:"warn_at"`
+}
+
+// BudgetBreach is the result of checking month-to-date spend against a
+// Budget.
+type BudgetBreach struct {
+	Budget     Budget             `json:"budget"`
+	Spend      float64            `json:"spend"`
+	Categories map[string]float64 `json:"categories"`
+}
+
+func loadBudgets() ([]Budget, error) {
+	var budgets []Budget
+	if err := viper.UnmarshalKey(budgetConfigKey, &budgets); err != nil {
+		return nil, fmt.Errorf("error reading budgets from config : %v", err)
+	}
+	return budgets, nil
+}
+
+func saveBudgets(budgets []Budget) error {
+	viper.Set(budgetConfigKey, budgets)
+	if err := viper.WriteConfig(); err != nil {
+		return fmt.Errorf("error writing budgets to config : %v", err)
+	}
+	return nil
+}
+
+func setBudget(name string, monthly, warnAt float64) ([]Budget, error) {
+	if monthly <= 0 {
+		return nil, errors.New("--monthly is required and must be greater than 0")
+	}
+
+	budgets, err := loadBudgets()
+	if err != nil {
+		return nil, err
+	}
+
+	updated := Budget{Name: name, Monthly: monthly, WarnAt: warnAt}
+	for i, b := range budgets {
+		if b.Name == name {
+			budgets[i] = updated
+			return budgets, saveBudgets(budgets)
+		}
+	}
+
+	budgets = append(budgets, updated)
+	sort.Slice(budgets, func(i, j int) bool { return budgets[i].Name < budgets[j].Name })
+
+	return budgets, saveBudgets(budgets)
+}
+
+func deleteBudget(name string) error {
+	budgets, err := loadBudgets()
+	if err != nil {
+		return err
+	}
+
+	kept := make([]Budget, 0, len(budgets))
+	for _, b := range budgets {
+		if b.Name != name {
+			kept = append(kept, b)
+		}
+	}
+
+	return saveBudgets(kept)
+}
+
+func findBudget(name string) (*Budget, error) {
+	budgets, err := loadBudgets()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range budgets {
+		if budgets[i].Name == name {
+			return &budgets[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no budget named %q, run `vultr-cli billing budget set` first", name)
+}
+
+// checkBudget computes month-to-date spend from the billing history reused
+// by `billing summary` and compares it against the named budget, returning
+// the process exit code a cron/CI caller should use: 0 clean, 2 warning
+// threshold exceeded, 3 hard monthly limit exceeded.
+func (b *options) checkBudget(name string) (*BudgetBreach, int, error) {
+	budget, err := findBudget(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	b.ensurePagingOptions()
+	b.Follow = true
+	history, _, err := b.listHistory()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	categories := map[string]float64{}
+	var spend float64
+
+	for _, h := range history {
+		t, errParse := time.Parse(time.RFC3339, h.Date)
+		if errParse != nil || t.Year() != now.Year() || t.Month() != now.Month() {
+			continue
+		}
+
+		spend += h.Amount
+		categories[categoryFor(h.Description)] += h.Amount
+	}
+
+	return &BudgetBreach{Budget: *budget, Spend: spend, Categories: categories}, evaluateBudget(*budget, spend), nil
+}
+
+// evaluateBudget maps month-to-date spend against a budget's thresholds to
+// the process exit code `billing budget check` should use: 0 clean, 2 warn
+// threshold exceeded, 3 hard monthly limit exceeded. A zero-valued threshold
+// is treated as unset.
+func evaluateBudget(budget Budget, spend float64) int {
+	switch {
+	case budget.Monthly > 0 && spend >= budget.Monthly:
+		return 3
+	case budget.WarnAt > 0 && spend >= budget.WarnAt:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,41 @@
+package billing
+
+import (
+	"testing"
+)
+
+func TestSetBudgetRejectsNonPositiveMonthly(t *testing.T) {
+	tests := []float64{0, -1, -100}
+
+	for _, monthly := range tests {
+		if _, err := setBudget("default", monthly, 0); err == nil {
+			t.Errorf("setBudget(%v, 0) = nil error, want an error rejecting a non-positive --monthly", monthly)
+		}
+	}
+}
+
+func TestEvaluateBudget(t *testing.T) {
+	tests := []struct {
+		name   string
+		budget Budget
+		spend  float64
+		want   int
+	}{
+		{"under both thresholds", Budget{Monthly: 100, WarnAt: 80}, 50, 0},
+		{"at warn threshold", Budget{Monthly: 100, WarnAt: 80}, 80, 2},
+		{"between warn and hard limit", Budget{Monthly: 100, WarnAt: 80}, 95, 2},
+		{"at hard limit", Budget{Monthly: 100, WarnAt: 80}, 100, 3},
+		{"over hard limit", Budget{Monthly: 100, WarnAt: 80}, 150, 3},
+		{"unset thresholds never breach", Budget{}, 1000, 0},
+		{"only hard limit set", Budget{Monthly: 100}, 150, 3},
+		{"only warn threshold set", Budget{WarnAt: 80}, 90, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := evaluateBudget(tt.budget, tt.spend); got != tt.want {
+				t.Errorf("evaluateBudget(%+v, %v) = %d, want %d", tt.budget, tt.spend, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package billing
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/vultr-cli/v3/cmd/utils"
+)
+
+// addCursorFlags registers the Stripe-style pagination flags shared by the
+// billing list commands, layered on top of the existing --cursor/--per-page
+// pair.
+func addCursorFlags(cmd *cobra.Command) {
+	cmd.Flags().String("starting-after", "", "(optional) Return results after this cursor/ID, takes precedence over --cursor.")
+	cmd.Flags().String("ending-before", "", "(optional) Accepted for Stripe-style compatibility, currently has no effect: the Vultr API only supports paging forward.")
+	cmd.Flags().Int("limit", 0, "(optional) Alias for --per-page, takes precedence when set.")
+	cmd.Flags().Bool("follow", false, "(optional) Automatically page through all results and return them as a single list.")
+}
+
+// setCursorOptions reads the Stripe-style flags and folds them into
+// o.Base.Options, which utils.GetPaging has already populated from
+// --cursor/--per-page. b.EndingBefore is recorded but never folded into
+// Base.Options: the Vultr API has no backward cursor, so there is nothing
+// for it to affect yet.
+func (b *options) setCursorOptions(cmd *cobra.Command) {
+	b.StartingAfter, _ = cmd.Flags().GetString("starting-after")
+	b.EndingBefore, _ = cmd.Flags().GetString("ending-before")
+	b.Follow, _ = cmd.Flags().GetBool("follow")
+
+	if b.StartingAfter != "" {
+		b.Base.Options.Cursor = b.StartingAfter
+	}
+
+	if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+		b.Base.Options.PerPage = limit
+	}
+}
+
+// page builds the pagination envelope reported by the billing printers.
+// Previous reflects whether this request actually carried a cursor
+// (--cursor, --starting-after, or a prior page's next-cursor), not whether
+// --ending-before was passed: that flag has no effect on the request today,
+// so it must not be allowed to affect what we report either. firstID/lastID
+// are the first and last IDs the server returned for this page, for
+// scripted backfills that page by ID rather than by opaque cursor.
+func (b *options) page(meta *govultr.Meta, firstID, lastID string) BillingPage {
+	page := BillingPage{
+		Previous: b.Base.Options != nil && b.Base.Options.Cursor != "",
+		FirstID:  firstID,
+		LastID:   lastID,
+	}
+
+	if meta != nil && meta.Links != nil {
+		page.Next = meta.Links.Next != ""
+		page.NextCursor = meta.Links.Next
+	}
+
+	return page
+}
+
+// firstLastInvoiceIDs returns the first and last invoice ID the server
+// returned for this page, or "" if the page was empty.
+func firstLastInvoiceIDs(invs []govultr.Invoice) (string, string) {
+	if len(invs) == 0 {
+		return "", ""
+	}
+	return fmt.Sprintf("%d", invs[0].ID), fmt.Sprintf("%d", invs[len(invs)-1].ID)
+}
+
+// firstLastHistoryIDs returns the first and last billing history ID the
+// server returned for this page, or "" if the page was empty.
+func firstLastHistoryIDs(hs []govultr.History) (string, string) {
+	if len(hs) == 0 {
+		return "", ""
+	}
+	return fmt.Sprintf("%d", hs[0].ID), fmt.Sprintf("%d", hs[len(hs)-1].ID)
+}
+
+// advanceCursor moves opts.Cursor to the next page's cursor, returning false
+// once the server reports there's nothing left to fetch or opts is nil.
+func advanceCursor(opts *govultr.ListOptions, meta *govultr.Meta) bool {
+	if opts == nil || meta == nil || meta.Links == nil || meta.Links.Next == "" {
+		return false
+	}
+
+	opts.Cursor = meta.Links.Next
+	return true
+}
+
+// ensurePagingOptions guarantees Base.Options is non-nil before a command
+// auto-follows across pages without first going through utils.GetPaging
+// (summary and budget check always follow the full history).
+func (b *options) ensurePagingOptions() {
+	if b.Base.Options == nil {
+		b.Base.Options = &govultr.ListOptions{PerPage: utils.PerPageDefault}
+	}
+}
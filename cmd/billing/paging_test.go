@@ -0,0 +1,86 @@
+package billing
+
+import (
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func TestAdvanceCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *govultr.ListOptions
+		meta    *govultr.Meta
+		want    bool
+		wantErr string
+	}{
+		{
+			name: "advances when another page is available",
+			opts: &govultr.ListOptions{},
+			meta: &govultr.Meta{Links: &govultr.Links{Next: "cursor-2"}},
+			want: true,
+		},
+		{
+			name: "stops when the next cursor is empty",
+			opts: &govultr.ListOptions{},
+			meta: &govultr.Meta{Links: &govultr.Links{Next: ""}},
+			want: false,
+		},
+		{
+			name: "stops when meta is nil",
+			opts: &govultr.ListOptions{},
+			meta: nil,
+			want: false,
+		},
+		{
+			name: "stops when meta.Links is nil",
+			opts: &govultr.ListOptions{},
+			meta: &govultr.Meta{},
+			want: false,
+		},
+		{
+			name: "does not panic when opts is nil",
+			opts: nil,
+			meta: &govultr.Meta{Links: &govultr.Links{Next: "cursor-2"}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := advanceCursor(tt.opts, tt.meta)
+			if got != tt.want {
+				t.Errorf("advanceCursor() = %v, want %v", got, tt.want)
+			}
+			if got && tt.opts.Cursor != tt.meta.Links.Next {
+				t.Errorf("advanceCursor() left opts.Cursor = %q, want %q", tt.opts.Cursor, tt.meta.Links.Next)
+			}
+		})
+	}
+}
+
+func TestFirstLastInvoiceIDs(t *testing.T) {
+	first, last := firstLastInvoiceIDs(nil)
+	if first != "" || last != "" {
+		t.Errorf("firstLastInvoiceIDs(nil) = (%q, %q), want empty strings", first, last)
+	}
+
+	invs := []govultr.Invoice{{ID: 1}, {ID: 2}, {ID: 3}}
+	first, last = firstLastInvoiceIDs(invs)
+	if first != "1" || last != "3" {
+		t.Errorf("firstLastInvoiceIDs(...) = (%q, %q), want (\"1\", \"3\")", first, last)
+	}
+}
+
+func TestFirstLastHistoryIDs(t *testing.T) {
+	first, last := firstLastHistoryIDs(nil)
+	if first != "" || last != "" {
+		t.Errorf("firstLastHistoryIDs(nil) = (%q, %q), want empty strings", first, last)
+	}
+
+	hs := []govultr.History{{ID: 10}, {ID: 20}}
+	first, last = firstLastHistoryIDs(hs)
+	if first != "10" || last != "20" {
+		t.Errorf("firstLastHistoryIDs(...) = (%q, %q), want (\"10\", \"20\")", first, last)
+	}
+}
@@ -0,0 +1,314 @@
+package billing
+
+import (
+	"fmt"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/vultr-cli/v3/cmd/printer"
+)
+
+// BillingPage is a Stripe-style pagination envelope describing whether
+// further pages are available in either direction, plus the first/last ID
+// the server returned for this page.
+type BillingPage struct {
+	Next       bool
+	Previous   bool
+	NextCursor string
+	FirstID    string
+	LastID     string
+}
+
+func (p BillingPage) footer() []string {
+	return []string{fmt.Sprintf(
+		"page: next=%t previous=%t next-cursor=%s first-id=%s last-id=%s",
+		p.Next, p.Previous, p.NextCursor, p.FirstID, p.LastID,
+	)}
+}
+
+type BillingInvoicesPrinter struct {
+	Invoices []govultr.Invoice `json:"invoices"`
+	Meta     *govultr.Meta     `json:"meta"`
+	Page     BillingPage       `json:"-"`
+}
+
+func (b *BillingInvoicesPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingInvoicesPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingInvoicesPrinter) Columns() [][]string {
+	return [][]string{{"ID", "DESCRIPTION", "AMOUNT", "BALANCE", "DATE"}}
+}
+
+func (b *BillingInvoicesPrinter) Data() [][]string {
+	data := make([][]string, 0, len(b.Invoices))
+	for _, inv := range b.Invoices {
+		data = append(data, []string{
+			fmt.Sprintf("%d", inv.ID),
+			inv.Description,
+			fmt.Sprintf("%.2f", inv.Amount),
+			fmt.Sprintf("%.2f", inv.Balance),
+			inv.Date,
+		})
+	}
+	return data
+}
+
+func (b *BillingInvoicesPrinter) Paging() [][]string {
+	return [][]string{b.Page.footer()}
+}
+
+type BillingInvoicePrinter struct {
+	Invoice govultr.Invoice `json:"invoice"`
+}
+
+func (b *BillingInvoicePrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingInvoicePrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingInvoicePrinter) Columns() [][]string {
+	return [][]string{{"ID", "DESCRIPTION", "AMOUNT", "BALANCE", "DATE"}}
+}
+
+func (b *BillingInvoicePrinter) Data() [][]string {
+	return [][]string{{
+		fmt.Sprintf("%d", b.Invoice.ID),
+		b.Invoice.Description,
+		fmt.Sprintf("%.2f", b.Invoice.Amount),
+		fmt.Sprintf("%.2f", b.Invoice.Balance),
+		b.Invoice.Date,
+	}}
+}
+
+func (b *BillingInvoicePrinter) Paging() [][]string {
+	return nil
+}
+
+type BillingInvoiceItemsPrinter struct {
+	InvoiceItems []govultr.InvoiceItem `json:"invoice_items"`
+	Meta         *govultr.Meta         `json:"meta"`
+	Page         BillingPage           `json:"-"`
+	NoColor      bool                  `json:"-"`
+}
+
+func (b *BillingInvoiceItemsPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingInvoiceItemsPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingInvoiceItemsPrinter) Columns() [][]string {
+	return [][]string{{"DESCRIPTION", "START", "END", "UNIT TYPE", "QUANTITY", "UNIT PRICE", "TOTAL"}}
+}
+
+func (b *BillingInvoiceItemsPrinter) Data() [][]string {
+	data := make([][]string, 0, len(b.InvoiceItems))
+	for _, item := range b.InvoiceItems {
+		data = append(data, []string{
+			item.Description,
+			printer.Colorize(item.StartDate, printer.DefaultTheme.Date, b.NoColor),
+			printer.Colorize(item.EndDate, printer.DefaultTheme.Date, b.NoColor),
+			item.UnitType,
+			fmt.Sprintf("%d", item.Quantity),
+			fmt.Sprintf("%.2f", item.UnitPrice),
+			colorizeAmount(item.Total, b.NoColor),
+		})
+	}
+	return data
+}
+
+func (b *BillingInvoiceItemsPrinter) Paging() [][]string {
+	return [][]string{b.Page.footer()}
+}
+
+type BillingHistoryPrinter struct {
+	Billing []govultr.History `json:"billing_history"`
+	Meta    *govultr.Meta     `json:"meta"`
+	Page    BillingPage       `json:"-"`
+	NoColor bool              `json:"-"`
+}
+
+func (b *BillingHistoryPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingHistoryPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingHistoryPrinter) Columns() [][]string {
+	return [][]string{{"ID", "DATE", "TYPE", "DESCRIPTION", "AMOUNT", "BALANCE"}}
+}
+
+func (b *BillingHistoryPrinter) Data() [][]string {
+	data := make([][]string, 0, len(b.Billing))
+	for _, h := range b.Billing {
+		data = append(data, []string{
+			fmt.Sprintf("%d", h.ID),
+			printer.Colorize(h.Date, printer.DefaultTheme.Date, b.NoColor),
+			h.Type,
+			h.Description,
+			colorizeAmount(h.Amount, b.NoColor),
+			fmt.Sprintf("%.2f", h.Balance),
+		})
+	}
+	return data
+}
+
+// colorizeAmount greens out a positive amount and reds out a negative one,
+// so long billing-history/invoice-item tables are easier to skim.
+func colorizeAmount(amount float64, noColor bool) string {
+	s := fmt.Sprintf("%.2f", amount)
+	if amount < 0 {
+		return printer.Colorize(s, printer.DefaultTheme.NegativeAmount, noColor)
+	}
+	return printer.Colorize(s, printer.DefaultTheme.PositiveAmount, noColor)
+}
+
+func (b *BillingHistoryPrinter) Paging() [][]string {
+	return [][]string{b.Page.footer()}
+}
+
+// BillingInvoicePDFPrinter reports the result of rendering an invoice to
+// a file on disk.
+type BillingInvoicePDFPrinter struct {
+	Path  string `json:"path"`
+	Bytes int    `json:"bytes"`
+}
+
+func (b *BillingInvoicePDFPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingInvoicePDFPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingInvoicePDFPrinter) Columns() [][]string {
+	return [][]string{{"PATH", "BYTES"}}
+}
+
+func (b *BillingInvoicePDFPrinter) Data() [][]string {
+	return [][]string{{b.Path, fmt.Sprintf("%d", b.Bytes)}}
+}
+
+func (b *BillingInvoicePDFPrinter) Paging() [][]string {
+	return nil
+}
+
+// BillingSummaryPrinter renders the period x category (x region) matrix
+// produced by the `billing summary` command.
+type BillingSummaryPrinter struct {
+	Rows          []summaryRow `json:"rows"`
+	GroupByRegion bool         `json:"-"`
+}
+
+func (b *BillingSummaryPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingSummaryPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingSummaryPrinter) Columns() [][]string {
+	if b.GroupByRegion {
+		return [][]string{{"PERIOD", "CATEGORY", "REGION", "AMOUNT"}}
+	}
+	return [][]string{{"PERIOD", "CATEGORY", "AMOUNT"}}
+}
+
+func (b *BillingSummaryPrinter) Data() [][]string {
+	data := make([][]string, 0, len(b.Rows))
+	for _, row := range b.Rows {
+		amount := fmt.Sprintf("%.2f", float64(row.Amount)/100)
+		if b.GroupByRegion {
+			data = append(data, []string{row.Period, row.Category, row.Region, amount})
+			continue
+		}
+		data = append(data, []string{row.Period, row.Category, amount})
+	}
+	return data
+}
+
+func (b *BillingSummaryPrinter) Paging() [][]string {
+	return nil
+}
+
+// BillingBudgetPrinter renders the set of local spend budgets.
+type BillingBudgetPrinter struct {
+	Budgets []Budget `json:"budgets"`
+}
+
+func (b *BillingBudgetPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingBudgetPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingBudgetPrinter) Columns() [][]string {
+	return [][]string{{"NAME", "MONTHLY", "WARN AT"}}
+}
+
+func (b *BillingBudgetPrinter) Data() [][]string {
+	data := make([][]string, 0, len(b.Budgets))
+	for _, bud := range b.Budgets {
+		data = append(data, []string{bud.Name, fmt.Sprintf("%.2f", bud.Monthly), fmt.Sprintf("%.2f", bud.WarnAt)})
+	}
+	return data
+}
+
+func (b *BillingBudgetPrinter) Paging() [][]string {
+	return nil
+}
+
+// BillingBudgetCheckPrinter renders the result of `billing budget check`.
+type BillingBudgetCheckPrinter struct {
+	Breach BudgetBreach `json:"breach"`
+}
+
+func (b *BillingBudgetCheckPrinter) JSON() []byte {
+	return printer.MarshalObject(b)
+}
+
+func (b *BillingBudgetCheckPrinter) Yaml() []byte {
+	return printer.MarshalObjectYaml(b)
+}
+
+func (b *BillingBudgetCheckPrinter) Columns() [][]string {
+	return [][]string{{"BUDGET", "MONTHLY", "WARN AT", "MONTH-TO-DATE SPEND", "TOP CATEGORY"}}
+}
+
+func (b *BillingBudgetCheckPrinter) Data() [][]string {
+	var top string
+	var topAmount float64
+	for category, amount := range b.Breach.Categories {
+		if amount > topAmount {
+			top = category
+			topAmount = amount
+		}
+	}
+
+	return [][]string{{
+		b.Breach.Budget.Name,
+		fmt.Sprintf("%.2f", b.Breach.Budget.Monthly),
+		fmt.Sprintf("%.2f", b.Breach.Budget.WarnAt),
+		fmt.Sprintf("%.2f", b.Breach.Spend),
+		top,
+	}}
+}
+
+func (b *BillingBudgetCheckPrinter) Paging() [][]string {
+	return nil
+}
@@ -0,0 +1,155 @@
+package billing
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// categoryPrefixes are matched against the start of an invoice/history
+// description to bucket it into a spend category.
+var categoryPrefixes = []string{
+	"Compute",
+	"Bandwidth Overage",
+	"Bandwidth",
+	"Block Storage",
+	"Object Storage",
+	"Load Balancer",
+	"Kubernetes",
+	"Managed Database",
+	"Snapshot",
+	"Reserved IP",
+	"DNS",
+}
+
+// regionPattern pulls a region slug such as "ewr" or "lon1" out of an
+// invoice/history description. The API doesn't expose a region field on
+// billing history, so this is a best-effort fallback based on the
+// "<service> - <label> (<region>)" shape most line items use, and it
+// falls back to "unknown" for anything that doesn't match.
+var regionPattern = regexp.MustCompile(`\(([a-z]{3}[0-9]?)\)\s*$`)
+
+type summaryOptions struct {
+	From          time.Time
+	To            time.Time
+	GroupBy       string
+	GroupByRegion bool
+}
+
+type summaryRow struct {
+	Period   string
+	Category string
+	Region   string
+	Amount   int64 // cents, to keep totals from drifting across many line items
+}
+
+func parseSummaryFlags(cmd *cobra.Command) (summaryOptions, error) {
+	var so summaryOptions
+
+	if from, _ := cmd.Flags().GetString("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return so, fmt.Errorf("invalid --from date : %v", err)
+		}
+		so.From = t
+	}
+
+	if to, _ := cmd.Flags().GetString("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return so, fmt.Errorf("invalid --to date : %v", err)
+		}
+		so.To = t
+	}
+
+	so.GroupBy, _ = cmd.Flags().GetString("group-by")
+	so.GroupByRegion, _ = cmd.Flags().GetBool("group-by-region")
+
+	return so, nil
+}
+
+func categoryFor(description string) string {
+	for _, prefix := range categoryPrefixes {
+		if strings.HasPrefix(description, prefix) {
+			return prefix
+		}
+	}
+	return "Other"
+}
+
+func regionFor(description string) string {
+	m := regionPattern.FindStringSubmatch(description)
+	if len(m) < 2 {
+		return "unknown"
+	}
+	return m[1]
+}
+
+func periodFor(date, groupBy string) string {
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return date
+	}
+
+	switch groupBy {
+	case "year":
+		return fmt.Sprintf("%d", t.Year())
+	case "quarter":
+		return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())-1)/3+1)
+	default:
+		return fmt.Sprintf("%d-%02d", t.Year(), t.Month())
+	}
+}
+
+// summary pulls the full billing history, auto-paging across cursors, and
+// aggregates it into a period x category (x region) matrix.
+func (b *options) summary(so summaryOptions) (*BillingSummaryPrinter, error) {
+	b.ensurePagingOptions()
+	b.Follow = true
+	history, _, err := b.listHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]int64{}
+	order := make([]string, 0)
+
+	for _, h := range history {
+		if t, err := time.Parse(time.RFC3339, h.Date); err == nil {
+			if !so.From.IsZero() && t.Before(so.From) {
+				continue
+			}
+			if !so.To.IsZero() && t.After(so.To) {
+				continue
+			}
+		}
+
+		period := periodFor(h.Date, so.GroupBy)
+		category := categoryFor(h.Description)
+		region := ""
+		if so.GroupByRegion {
+			region = regionFor(h.Description)
+		}
+
+		key := strings.Join([]string{period, category, region}, "\x00")
+		if _, ok := totals[key]; !ok {
+			order = append(order, key)
+		}
+		totals[key] += int64(math.Round(h.Amount * 100))
+	}
+
+	sort.Strings(order)
+
+	rows := make([]summaryRow, 0, len(order))
+	for _, key := range order {
+		parts := strings.SplitN(key, "\x00", 3)
+		rows = append(rows, summaryRow{Period: parts[0], Category: parts[1], Region: parts[2], Amount: totals[key]})
+	}
+
+	return &BillingSummaryPrinter{Rows: rows, GroupByRegion: so.GroupByRegion}, nil
+}
@@ -0,0 +1,68 @@
+package billing
+
+import "testing"
+
+func TestCategoryFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{"compute", "Compute Instance - vc2-1c-1gb (ewr)", "Compute"},
+		{"bandwidth overage before bandwidth", "Bandwidth Overage - Instance", "Bandwidth Overage"},
+		{"bandwidth", "Bandwidth - Instance", "Bandwidth"},
+		{"block storage", "Block Storage - 100GB (ewr)", "Block Storage"},
+		{"unrecognized prefix falls back to other", "Marketplace App Fee", "Other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := categoryFor(tt.description); got != tt.want {
+				t.Errorf("categoryFor(%q) = %q, want %q", tt.description, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{"three letter region", "Compute Instance - vc2-1c-1gb (ewr)", "ewr"},
+		{"region with trailing digit", "Compute Instance - vc2-1c-1gb (lon1)", "lon1"},
+		{"no region suffix falls back to unknown", "Marketplace App Fee", "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := regionFor(tt.description); got != tt.want {
+				t.Errorf("regionFor(%q) = %q, want %q", tt.description, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    string
+		groupBy string
+		want    string
+	}{
+		{"month", "2026-02-15T00:00:00Z", "month", "2026-02"},
+		{"quarter", "2026-02-15T00:00:00Z", "quarter", "2026-Q1"},
+		{"quarter rolls at boundary", "2026-04-01T00:00:00Z", "quarter", "2026-Q2"},
+		{"year", "2026-02-15T00:00:00Z", "year", "2026"},
+		{"unparseable date falls back to raw value", "not-a-date", "month", "not-a-date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := periodFor(tt.date, tt.groupBy); got != tt.want {
+				t.Errorf("periodFor(%q, %q) = %q, want %q", tt.date, tt.groupBy, got, tt.want)
+			}
+		})
+	}
+}
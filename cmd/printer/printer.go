@@ -0,0 +1,253 @@
+// Package printer renders command output as table, JSON, YAML, or CSV, and
+// pages long table output through the user's pager.
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+)
+
+// ResourceOutput is implemented by each command's own result type so
+// Printer can render it generically across output formats.
+type ResourceOutput interface {
+	JSON() []byte
+	Yaml() []byte
+	Columns() [][]string
+	Data() [][]string
+	Paging() [][]string
+}
+
+// Theme controls the colorization of table output.
+type Theme struct {
+	Header         string
+	PositiveAmount string
+	NegativeAmount string
+	Date           string
+}
+
+// DefaultTheme colorizes headers cyan, positive amounts green, negative
+// amounts red, and dims dates.
+var DefaultTheme = Theme{
+	Header:         "\033[1;36m",
+	PositiveAmount: "\033[32m",
+	NegativeAmount: "\033[31m",
+	Date:           "\033[2m",
+}
+
+const resetColor = "\033[0m"
+
+// Colorize wraps s in color unless noColor is set or color is empty.
+func Colorize(s, color string, noColor bool) string {
+	if noColor || color == "" {
+		return s
+	}
+	return color + s + resetColor
+}
+
+// Printer renders ResourceOutput values and owns the output format, color,
+// and pager settings inherited from the root command's persistent flags.
+type Printer struct {
+	Output  string
+	NoColor bool
+	Theme   Theme
+	Pager   string
+}
+
+// ColorEnabled reports whether a ResourceOutput should colorize the cells it
+// builds in Data(). Color only ever makes sense for an interactive table on
+// a real terminal, never for JSON/YAML/CSV or a redirected/piped table,
+// since escape codes would otherwise corrupt those outputs.
+func (p *Printer) ColorEnabled() bool {
+	if p.NoColor {
+		return false
+	}
+	if p.Output != "" && p.Output != "table" {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// RegisterFlags adds the --no-color, --theme, and --pager flags. Intended to
+// be called once against the root command so every subcommand inherits them.
+func RegisterFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool("no-color", false, "(optional) Disable colorized table output.")
+	cmd.PersistentFlags().String("theme", "default", "(optional) Table color theme: default or no-color.")
+	cmd.PersistentFlags().String(
+		"pager",
+		"",
+		"(optional) Command used to page long table output. Defaults to $VULTR_PAGER, then $PAGER.",
+	)
+}
+
+// NewPrinter resolves pager and color settings from flags and environment:
+// --pager takes precedence over $VULTR_PAGER, which takes precedence over
+// $PAGER.
+func NewPrinter(output string, noColor bool, theme, pagerFlag string) *Printer {
+	p := &Printer{Output: output, NoColor: noColor, Theme: DefaultTheme}
+
+	pager := pagerFlag
+	if pager == "" {
+		pager = os.Getenv("VULTR_PAGER")
+	}
+	if pager == "" {
+		pager = os.Getenv("PAGER")
+	}
+	p.Pager = pager
+
+	if theme == "no-color" {
+		p.NoColor = true
+	}
+
+	return p
+}
+
+// Error prints a CLI error to stderr.
+func Error(err error) {
+	fmt.Fprintln(os.Stderr, err)
+}
+
+// MarshalObject marshals a ResourceOutput-backed struct to indented JSON.
+func MarshalObject(v interface{}) []byte {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		Error(fmt.Errorf("error marshaling json : %v", err))
+		return nil
+	}
+	return out
+}
+
+// MarshalObjectYaml marshals a ResourceOutput-backed struct to YAML.
+func MarshalObjectYaml(v interface{}) []byte {
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		Error(fmt.Errorf("error marshaling yaml : %v", err))
+		return nil
+	}
+	return out
+}
+
+// Display renders output without ever routing it through a pager. Every
+// command that has not opted into DisplayPaged uses it, whatever the length
+// of its results.
+func (p *Printer) Display(output ResourceOutput, err error) {
+	if err != nil {
+		Error(err)
+		os.Exit(1)
+	}
+	p.render(os.Stdout, output)
+}
+
+// DisplayPaged renders output like Display, but pipes it through the
+// resolved pager when stdout is a terminal and the table is taller than the
+// terminal. Commands with potentially long result sets (invoice items,
+// billing history) opt into this instead of Display.
+func (p *Printer) DisplayPaged(output ResourceOutput, err error) {
+	if err != nil {
+		Error(err)
+		os.Exit(1)
+	}
+
+	if p.Output != "" && p.Output != "table" {
+		p.render(os.Stdout, output)
+		return
+	}
+
+	if p.Pager == "" || !term.IsTerminal(int(os.Stdout.Fd())) {
+		p.render(os.Stdout, output)
+		return
+	}
+
+	var buf bytes.Buffer
+	p.render(&buf, output)
+
+	_, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || strings.Count(buf.String(), "\n") <= height {
+		fmt.Print(buf.String())
+		return
+	}
+
+	p.pipeToPager(buf.String())
+}
+
+func (p *Printer) pipeToPager(content string) {
+	fields := strings.Fields(p.Pager)
+	if len(fields) == 0 {
+		fmt.Print(content)
+		return
+	}
+
+	cmd := exec.Command(fields[0], fields[1:]...) //nolint:gosec
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		fmt.Print(content)
+		return
+	}
+
+	if err := cmd.Start(); err != nil {
+		fmt.Print(content)
+		return
+	}
+
+	io.WriteString(stdin, content) //nolint:errcheck
+	stdin.Close()
+	cmd.Wait() //nolint:errcheck
+}
+
+func (p *Printer) render(w io.Writer, output ResourceOutput) {
+	switch p.Output {
+	case "json":
+		fmt.Fprintln(w, string(output.JSON()))
+	case "yaml":
+		fmt.Fprintln(w, string(output.Yaml()))
+	case "csv":
+		p.renderDelimited(w, output, ",")
+	default:
+		p.renderTable(w, output)
+	}
+}
+
+func (p *Printer) renderDelimited(w io.Writer, output ResourceOutput, sep string) {
+	for _, row := range output.Columns() {
+		fmt.Fprintln(w, strings.Join(row, sep))
+	}
+	for _, row := range output.Data() {
+		fmt.Fprintln(w, strings.Join(row, sep))
+	}
+}
+
+func (p *Printer) renderTable(w io.Writer, output ResourceOutput) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	for _, row := range output.Columns() {
+		fmt.Fprintln(tw, p.colorizeHeader(row))
+	}
+	for _, row := range output.Data() {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+
+	for _, row := range output.Paging() {
+		fmt.Fprintln(w, strings.Join(row, " "))
+	}
+}
+
+func (p *Printer) colorizeHeader(row []string) string {
+	joined := strings.Join(row, "\t")
+	if !p.ColorEnabled() {
+		return joined
+	}
+	return p.Theme.Header + joined + resetColor
+}
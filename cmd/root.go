@@ -0,0 +1,45 @@
+// Package cmd wires up the vultr-cli root command and its subcommands.
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/vultr-cli/v3/cmd/billing"
+	"github.com/vultr/vultr-cli/v3/cmd/printer"
+	"github.com/vultr/vultr-cli/v3/pkg/cli"
+)
+
+// NewRootCmd builds the vultr-cli root command. It registers the
+// output/pager/color/theme flags every subcommand inherits, and constructs
+// the shared cli.Base those subcommands read from.
+func NewRootCmd(client *govultr.Client) *cobra.Command {
+	base := &cli.Base{
+		Client:  client,
+		Context: context.Background(),
+	}
+
+	root := &cobra.Command{
+		Use:   "vultr-cli",
+		Short: "vultr-cli is a command line interface for the Vultr API",
+	}
+
+	root.PersistentFlags().StringP("output", "o", "table", "(optional) Output format: table, json, yaml, or csv.")
+	printer.RegisterFlags(root)
+
+	// The Printer is built in an initializer rather than a root
+	// PersistentPreRun: cobra runs only the nearest PersistentPreRun, and
+	// subcommands such as billing define their own.
+	cobra.OnInitialize(func() {
+		output, _ := root.PersistentFlags().GetString("output")
+		noColor, _ := root.PersistentFlags().GetBool("no-color")
+		theme, _ := root.PersistentFlags().GetString("theme")
+		pager, _ := root.PersistentFlags().GetString("pager")
+		base.Printer = printer.NewPrinter(output, noColor, theme, pager)
+	})
+
+	root.AddCommand(billing.NewCmdBilling(base))
+
+	return root
+}
@@ -0,0 +1,21 @@
+// Package cli holds the state shared by every command: the configured
+// govultr client, the parsed global flags, and the Printer used to render
+// results.
+package cli
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/vultr-cli/v3/cmd/printer"
+)
+
+// Base is threaded through every command's options struct.
+type Base struct {
+	Client  *govultr.Client
+	Context context.Context
+	Args    []string
+	Options *govultr.ListOptions
+	HasAuth bool
+	Printer *printer.Printer
+}